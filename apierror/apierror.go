@@ -0,0 +1,124 @@
+// Package apierror standardizes the JSON error envelope returned by every
+// handler, replacing the ad-hoc gin.H{"error": ...} / gin.H{"message": ...}
+// shapes that used to be scattered across the codebase.
+package apierror
+
+import (
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// init registers a tag-name function on gin's shared validator engine so
+// that validator.FieldError.Field() reports the request's json tag (e.g.
+// "day") instead of the Go struct field name (e.g. "Day"). Every DTO's
+// binding tags are validated through this same engine, so this needs to
+// happen exactly once, here, rather than in each handler package.
+func init() {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+}
+
+const (
+	CodeValidationError = "VALIDATION_ERROR"
+	CodeBadRequest      = "BAD_REQUEST"
+	CodeUnauthorized    = "UNAUTHORIZED"
+	CodeForbidden       = "FORBIDDEN"
+	CodeNotFound        = "NOT_FOUND"
+	CodeInternal        = "INTERNAL_ERROR"
+)
+
+// Body is the shape of the "error" key in every error response.
+type Body struct {
+	Code    string            `json:"code"`
+	Message string            `json:"message"`
+	Fields  map[string]string `json:"fields,omitempty"`
+}
+
+// respond writes {"error": body} with the given HTTP status and aborts the
+// request chain.
+func respond(c *gin.Context, status int, body Body) {
+	c.AbortWithStatusJSON(status, gin.H{"error": body})
+}
+
+// BadRequest reports a malformed request that isn't a field-level
+// validation failure (e.g. unparseable JSON, invalid query parameter).
+func BadRequest(c *gin.Context, message string) {
+	respond(c, http.StatusBadRequest, Body{Code: CodeBadRequest, Message: message})
+}
+
+// Validation reports a field-level validation failure, translating
+// validator.ValidationErrors into a map of field -> message. Any other bind
+// error is reported through BadRequest instead.
+func Validation(c *gin.Context, err error) {
+	verrs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		// Not a field-level failure (e.g. unparseable JSON).
+		BadRequest(c, "Invalid request body")
+		return
+	}
+	fields := map[string]string{}
+	for _, fe := range verrs {
+		fields[fe.Field()] = fieldMessage(fe)
+	}
+	respond(c, http.StatusBadRequest, Body{
+		Code:    CodeValidationError,
+		Message: "Validation failed",
+		Fields:  fields,
+	})
+}
+
+// Unauthorized reports a missing or invalid authentication credential.
+func Unauthorized(c *gin.Context, message string) {
+	respond(c, http.StatusUnauthorized, Body{Code: CodeUnauthorized, Message: message})
+}
+
+// Forbidden reports an authenticated caller lacking the required role.
+func Forbidden(c *gin.Context, message string) {
+	respond(c, http.StatusForbidden, Body{Code: CodeForbidden, Message: message})
+}
+
+// NotFound reports a missing resource.
+func NotFound(c *gin.Context, message string) {
+	respond(c, http.StatusNotFound, Body{Code: CodeNotFound, Message: message})
+}
+
+// Internal logs an unexpected server-side failure and reports it to the
+// caller as a generic message — the underlying error text (driver errors,
+// SQL detail, column names) may leak implementation or data detail, so it
+// never reaches the response body.
+func Internal(c *gin.Context, err error) {
+	log.Printf("internal error handling %s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+	respond(c, http.StatusInternalServerError, Body{Code: CodeInternal, Message: "An internal error occurred"})
+}
+
+// fieldMessage turns a single validator.FieldError into a human-readable
+// message for the given tag.
+func fieldMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return fe.Field() + " is required"
+	case "min":
+		return fe.Field() + " must be at least " + fe.Param() + " characters"
+	case "max":
+		return fe.Field() + " must be at most " + fe.Param() + " characters"
+	case "gt":
+		return fe.Field() + " must be greater than " + fe.Param()
+	default:
+		return fe.Field() + " is invalid"
+	}
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+
+	"go-crud-data/apierror"
+	"go-crud-data/middleware"
+	"go-crud-data/models"
+)
+
+// AuthHandler handles account registration and login
+type AuthHandler struct {
+	db        *sql.DB
+	jwtSecret string
+	jwtExpiry time.Duration
+}
+
+// NewAuthHandler constructs an AuthHandler backed by db, signing tokens
+// with jwtSecret and setting them to expire after jwtExpiry.
+func NewAuthHandler(db *sql.DB, jwtSecret string, jwtExpiry time.Duration) *AuthHandler {
+	return &AuthHandler{db: db, jwtSecret: jwtSecret, jwtExpiry: jwtExpiry}
+}
+
+// selfRegisterRole is the only role a caller can land themselves in via
+// POST /register; anything higher must go through UpdateUserRole.
+const selfRegisterRole = "viewer"
+
+type registerRequest struct {
+	Username string `json:"username" binding:"required,min=1,max=255"`
+	Password string `json:"password" binding:"required,min=8,max=255"`
+}
+
+type loginRequest struct {
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+type updateUserRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=viewer editor admin"`
+}
+
+// Register creates a new user account with a bcrypt-hashed password.
+// Self-registration always lands in selfRegisterRole; a client-supplied
+// role would let anyone grant themselves write access, so it is ignored.
+func (h *AuthHandler) Register(c *gin.Context) {
+	var req registerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Validation(c, err)
+		return
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		apierror.Internal(c, err)
+		return
+	}
+
+	stmt := "INSERT INTO users (username, password_hash, role) VALUES (?, ?, ?)"
+	res, err := h.db.ExecContext(c.Request.Context(), stmt, req.Username, string(hash), selfRegisterRole)
+	if err != nil {
+		apierror.Internal(c, err)
+		return
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		apierror.Internal(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, models.User{ID: int(id), Username: req.Username, Role: selfRegisterRole})
+}
+
+// UpdateUserRole promotes or demotes an existing user's role. It is only
+// reachable by callers with the admin role (see the route wiring in main).
+func (h *AuthHandler) UpdateUserRole(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apierror.BadRequest(c, "id must be an integer")
+		return
+	}
+
+	var req updateUserRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Validation(c, err)
+		return
+	}
+
+	res, err := h.db.ExecContext(c.Request.Context(), "UPDATE users SET role = ? WHERE id = ?", req.Role, id)
+	if err != nil {
+		apierror.Internal(c, err)
+		return
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		apierror.Internal(c, err)
+		return
+	}
+	if rowsAffected == 0 {
+		apierror.NotFound(c, "User not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Role successfully updated"})
+}
+
+// Login verifies credentials and issues a signed JWT
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req loginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Validation(c, err)
+		return
+	}
+
+	var user models.User
+	stmt := "SELECT id, username, password_hash, role FROM users WHERE username = ?"
+	row := h.db.QueryRowContext(c.Request.Context(), stmt, req.Username)
+	if err := row.Scan(&user.ID, &user.Username, &user.PasswordHash, &user.Role); err != nil {
+		if err == sql.ErrNoRows {
+			apierror.Unauthorized(c, "Invalid username or password")
+		} else {
+			apierror.Internal(c, err)
+		}
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
+		apierror.Unauthorized(c, "Invalid username or password")
+		return
+	}
+
+	claims := middleware.Claims{
+		UserID:   user.ID,
+		Username: user.Username,
+		Role:     user.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(h.jwtExpiry)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString([]byte(h.jwtSecret))
+	if err != nil {
+		apierror.Internal(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"token": signed})
+}
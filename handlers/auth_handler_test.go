@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newTestAuthRouter(h *AuthHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/register", h.Register)
+	router.PUT("/users/:id/role", h.UpdateUserRole)
+	return router
+}
+
+// Validation failures return before the handler ever touches h.db, so a nil
+// *sql.DB is safe for these cases.
+func newValidationOnlyAuthHandler() *AuthHandler {
+	return NewAuthHandler(nil, "test-secret", time.Hour)
+}
+
+func TestAuthHandler_Register_ValidationFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       map[string]interface{}
+		wantFields map[string]string
+	}{
+		{
+			name:       "missing username",
+			body:       map[string]interface{}{"username": "", "password": "password123"},
+			wantFields: map[string]string{"username": "username is required"},
+		},
+		{
+			name:       "short password",
+			body:       map[string]interface{}{"username": "alice", "password": "short"},
+			wantFields: map[string]string{"password": "password must be at least 8 characters"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestAuthRouter(newValidationOnlyAuthHandler())
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/register", bytes.NewReader(body))
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d: %s", http.StatusBadRequest, rec.Code, rec.Body.String())
+			}
+
+			var resp struct {
+				Error struct {
+					Fields map[string]string `json:"fields"`
+				} `json:"error"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			for field, message := range tt.wantFields {
+				if got := resp.Error.Fields[field]; got != message {
+					t.Errorf("expected fields[%q] = %q, got %q (fields: %+v)", field, message, got, resp.Error.Fields)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthHandler_UpdateUserRole_ValidationFields(t *testing.T) {
+	router := newTestAuthRouter(newValidationOnlyAuthHandler())
+
+	body, _ := json.Marshal(map[string]interface{}{"role": "superadmin"})
+	req := httptest.NewRequest(http.MethodPut, "/users/1/role", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusBadRequest, rec.Code, rec.Body.String())
+	}
+
+	var resp struct {
+		Error struct {
+			Fields map[string]string `json:"fields"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if _, ok := resp.Error.Fields["role"]; !ok {
+		t.Fatalf("expected a %q entry in fields, got %+v", "role", resp.Error.Fields)
+	}
+}
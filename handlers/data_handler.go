@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"go-crud-data/apierror"
+	"go-crud-data/middleware"
+	"go-crud-data/models"
+	"go-crud-data/repository"
+)
+
+// DataHandler serves the /data CRUD endpoints
+type DataHandler struct {
+	repo repository.DataRepository
+}
+
+// NewDataHandler constructs a DataHandler backed by repo
+func NewDataHandler(repo repository.DataRepository) *DataHandler {
+	return &DataHandler{repo: repo}
+}
+
+// callerUsername returns the username attached to the request by the auth
+// middleware, or "unknown" if the context has none (e.g. in tests).
+func callerUsername(c *gin.Context) string {
+	value, exists := c.Get("user")
+	if !exists {
+		return "unknown"
+	}
+	claims, ok := value.(*middleware.Claims)
+	if !ok {
+		return "unknown"
+	}
+	return claims.Username
+}
+
+const (
+	defaultLimit = 50
+	maxLimit     = 1000
+)
+
+var listSortColumns = map[string]bool{
+	"date": true,
+	"day":  true,
+}
+
+// GetData handles the retrieval of data entries, with pagination,
+// filtering by day, and sorting.
+func (h *DataHandler) GetData(c *gin.Context) {
+	limit := defaultLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			apierror.BadRequest(c, "limit must be a positive integer")
+			return
+		}
+		if parsed > maxLimit {
+			parsed = maxLimit
+		}
+		limit = parsed
+	}
+
+	offset := 0
+	if raw := c.Query("offset"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			apierror.BadRequest(c, "offset must be a non-negative integer")
+			return
+		}
+		offset = parsed
+	}
+
+	sortColumn := c.DefaultQuery("sort_column", "date")
+	if !listSortColumns[sortColumn] {
+		apierror.BadRequest(c, "sort_column must be one of: date, day")
+		return
+	}
+
+	sortOrder := c.DefaultQuery("sort_order", "asc")
+	if sortOrder != "asc" && sortOrder != "desc" {
+		apierror.BadRequest(c, "sort_order must be 'asc' or 'desc'")
+		return
+	}
+
+	params := repository.ListParams{
+		Limit:      limit,
+		Offset:     offset,
+		Day:        c.Query("day"),
+		SortColumn: sortColumn,
+		SortOrder:  sortOrder,
+	}
+
+	entries, total, err := h.repo.GetAll(c.Request.Context(), params)
+	if err != nil {
+		apierror.Internal(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"items":  entries,
+		"total":  total,
+		"limit":  limit,
+		"offset": offset,
+	})
+}
+
+// GetDataByID handles the retrieval of a single data entry by its primary key
+func (h *DataHandler) GetDataByID(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apierror.BadRequest(c, "id must be an integer")
+		return
+	}
+
+	data, err := h.repo.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.NotFound(c, "Data not found")
+		} else {
+			apierror.Internal(c, err)
+		}
+		return
+	}
+	c.JSON(http.StatusOK, data)
+}
+
+// GetDataByDate handles the retrieval of a single data entry by its
+// (not necessarily unique) business date; the first match is returned.
+func (h *DataHandler) GetDataByDate(c *gin.Context) {
+	date, err := strconv.Atoi(c.Param("date"))
+	if err != nil {
+		apierror.BadRequest(c, "date must be an integer")
+		return
+	}
+
+	data, err := h.repo.GetByDate(c.Request.Context(), date)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.NotFound(c, "Data not found")
+		} else {
+			apierror.Internal(c, err)
+		}
+		return
+	}
+	c.JSON(http.StatusOK, data)
+}
+
+// CreateData handles the creation of a new data entry
+func (h *DataHandler) CreateData(c *gin.Context) {
+	var req models.CreateDataRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Validation(c, err)
+		return
+	}
+
+	log.Printf("createData: %s is creating entry for date %d", callerUsername(c), req.Date)
+
+	created, err := h.repo.Create(c.Request.Context(), models.Data{Date: req.Date, Day: req.Day, Tasks: req.Tasks})
+	if err != nil {
+		apierror.Internal(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+// UpdateData handles the updating of an existing data entry by its id
+func (h *DataHandler) UpdateData(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apierror.BadRequest(c, "id must be an integer")
+		return
+	}
+
+	var req models.UpdateDataRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Validation(c, err)
+		return
+	}
+
+	log.Printf("updateData: %s is updating entry %d", callerUsername(c), id)
+
+	update := models.Data{Date: req.Date, Day: req.Day, Tasks: req.Tasks}
+	if err := h.repo.Update(c.Request.Context(), id, update); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.NotFound(c, "Data not found")
+		} else {
+			apierror.Internal(c, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Data successfully updated"})
+}
+
+// DeleteData handles the deletion of a data entry by its id
+func (h *DataHandler) DeleteData(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apierror.BadRequest(c, "id must be an integer")
+		return
+	}
+
+	if err := h.repo.Delete(c.Request.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.NotFound(c, "Data not found")
+		} else {
+			apierror.Internal(c, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Data successfully deleted"})
+}
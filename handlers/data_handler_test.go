@@ -0,0 +1,288 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"go-crud-data/models"
+	"go-crud-data/repository"
+)
+
+func newTestRouter(h *DataHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/data", h.GetData)
+	router.GET("/data/id/:id", h.GetDataByID)
+	router.GET("/data/date/:date", h.GetDataByDate)
+	router.POST("/data", h.CreateData)
+	router.PUT("/data/id/:id", h.UpdateData)
+	router.DELETE("/data/id/:id", h.DeleteData)
+	return router
+}
+
+func TestDataHandler_CreateAndGet(t *testing.T) {
+	h := NewDataHandler(repository.NewInMemoryDataRepository())
+	router := newTestRouter(h)
+
+	body, _ := json.Marshal(models.Data{Date: 20240101, Day: "Monday", Tasks: "write tests"})
+	req := httptest.NewRequest(http.MethodPost, "/data", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, rec.Code, rec.Body.String())
+	}
+
+	var created models.Data
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if created.ID == 0 {
+		t.Fatalf("expected a non-zero id to be assigned, got %+v", created)
+	}
+	if created.Date != 20240101 {
+		t.Fatalf("expected date to be preserved as 20240101, got %d", created.Date)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/data/id/"+strconv.Itoa(created.ID), nil)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	var got models.Data
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if got.Day != "Monday" {
+		t.Errorf("expected day %q, got %q", "Monday", got.Day)
+	}
+}
+
+func TestDataHandler_GetData_PaginationAndFilter(t *testing.T) {
+	repo := repository.NewInMemoryDataRepository()
+	seed := []models.Data{
+		{Date: 20240101, Day: "Monday", Tasks: "a"},
+		{Date: 20240102, Day: "Tuesday", Tasks: "b"},
+		{Date: 20240103, Day: "Monday", Tasks: "c"},
+	}
+	for _, data := range seed {
+		if _, err := repo.Create(context.Background(), data); err != nil {
+			t.Fatalf("failed to seed repo: %v", err)
+		}
+	}
+	router := newTestRouter(NewDataHandler(repo))
+
+	req := httptest.NewRequest(http.MethodGet, "/data?day=Monday&sort_column=date&sort_order=desc&limit=10", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	var body struct {
+		Items  []models.Data `json:"items"`
+		Total  int           `json:"total"`
+		Limit  int           `json:"limit"`
+		Offset int           `json:"offset"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if body.Total != 2 {
+		t.Fatalf("expected total 2, got %d", body.Total)
+	}
+	if len(body.Items) != 2 || body.Items[0].Date != 20240103 {
+		t.Fatalf("expected entries sorted desc by date, got %+v", body.Items)
+	}
+}
+
+func TestDataHandler_GetData_RejectsInvalidSortColumn(t *testing.T) {
+	router := newTestRouter(NewDataHandler(repository.NewInMemoryDataRepository()))
+
+	req := httptest.NewRequest(http.MethodGet, "/data?sort_column=tasks", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+}
+
+func TestDataHandler_GetDataByDate_NotFound(t *testing.T) {
+	h := NewDataHandler(repository.NewInMemoryDataRepository())
+	router := newTestRouter(h)
+
+	req := httptest.NewRequest(http.MethodGet, "/data/date/99999999", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, rec.Code)
+	}
+}
+
+func TestDataHandler_UpdateData(t *testing.T) {
+	tests := []struct {
+		name       string
+		seed       *models.Data
+		body       models.Data
+		wantStatus int
+	}{
+		{
+			name:       "updates existing entry",
+			seed:       &models.Data{Date: 20240102, Day: "Tuesday", Tasks: "old"},
+			body:       models.Data{Date: 20240102, Day: "Tuesday", Tasks: "new"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing entry",
+			seed:       nil,
+			body:       models.Data{Date: 20240103, Day: "Wednesday", Tasks: "new"},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := repository.NewInMemoryDataRepository()
+			id := 999999
+			if tt.seed != nil {
+				created, err := repo.Create(context.Background(), *tt.seed)
+				if err != nil {
+					t.Fatalf("failed to seed repo: %v", err)
+				}
+				id = created.ID
+			}
+
+			router := newTestRouter(NewDataHandler(repo))
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPut, "/data/id/"+strconv.Itoa(id), bytes.NewReader(body))
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestDataHandler_CreateData_ValidationFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       map[string]interface{}
+		wantFields map[string]string
+	}{
+		{
+			name:       "missing day",
+			body:       map[string]interface{}{"date": 20240101, "day": "", "tasks": "x"},
+			wantFields: map[string]string{"day": "day is required"},
+		},
+		{
+			name:       "non-positive date",
+			body:       map[string]interface{}{"date": -1, "day": "Monday", "tasks": "x"},
+			wantFields: map[string]string{"date": "date must be greater than 0"},
+		},
+		{
+			name:       "oversized tasks",
+			body:       map[string]interface{}{"date": 20240101, "day": "Monday", "tasks": strings.Repeat("a", 10001)},
+			wantFields: map[string]string{"tasks": "tasks must be at most 10000 characters"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestRouter(NewDataHandler(repository.NewInMemoryDataRepository()))
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/data", bytes.NewReader(body))
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d: %s", http.StatusBadRequest, rec.Code, rec.Body.String())
+			}
+
+			var resp struct {
+				Error struct {
+					Fields map[string]string `json:"fields"`
+				} `json:"error"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			for field, message := range tt.wantFields {
+				if got := resp.Error.Fields[field]; got != message {
+					t.Errorf("expected fields[%q] = %q, got %q (fields: %+v)", field, message, got, resp.Error.Fields)
+				}
+			}
+		})
+	}
+}
+
+func TestDataHandler_UpdateData_ValidationFields(t *testing.T) {
+	repo := repository.NewInMemoryDataRepository()
+	created, err := repo.Create(context.Background(), models.Data{Date: 20240102, Day: "Tuesday", Tasks: "old"})
+	if err != nil {
+		t.Fatalf("failed to seed repo: %v", err)
+	}
+	router := newTestRouter(NewDataHandler(repo))
+
+	body, _ := json.Marshal(map[string]interface{}{"date": 20240102, "day": "", "tasks": "new"})
+	req := httptest.NewRequest(http.MethodPut, "/data/id/"+strconv.Itoa(created.ID), bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusBadRequest, rec.Code, rec.Body.String())
+	}
+
+	var resp struct {
+		Error struct {
+			Fields map[string]string `json:"fields"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if want := "day is required"; resp.Error.Fields["day"] != want {
+		t.Errorf("expected fields[%q] = %q, got %q (fields: %+v)", "day", want, resp.Error.Fields["day"], resp.Error.Fields)
+	}
+}
+
+func TestDataHandler_DeleteData(t *testing.T) {
+	repo := repository.NewInMemoryDataRepository()
+	created, err := repo.Create(context.Background(), models.Data{Date: 20240104, Day: "Thursday", Tasks: "x"})
+	if err != nil {
+		t.Fatalf("failed to seed repo: %v", err)
+	}
+	router := newTestRouter(NewDataHandler(repo))
+	idPath := "/data/id/" + strconv.Itoa(created.ID)
+
+	req := httptest.NewRequest(http.MethodDelete, idPath, nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, idPath, nil)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, rec.Code)
+	}
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HealthHandler serves liveness and readiness probes
+type HealthHandler struct {
+	db *sql.DB
+}
+
+// NewHealthHandler constructs a HealthHandler backed by db
+func NewHealthHandler(db *sql.DB) *HealthHandler {
+	return &HealthHandler{db: db}
+}
+
+// Liveness reports that the process is up and able to serve requests
+func (h *HealthHandler) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readiness reports whether the database is reachable
+func (h *HealthHandler) Readiness(c *gin.Context) {
+	if err := h.db.PingContext(c.Request.Context()); err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"status": "unavailable", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
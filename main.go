@@ -1,23 +1,26 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/joho/godotenv"
-	"log"
-	"net/http"
-	"os"
-)
 
-// Data represents a data entry in the database
-type Data struct {
-	Date  int    `json:"date"`
-	Day   string `json:"day"`
-	Tasks string `json:"tasks"`
-}
+	"go-crud-data/handlers"
+	"go-crud-data/middleware"
+	"go-crud-data/repository"
+)
 
 var db *sql.DB
 
@@ -35,6 +38,19 @@ func main() {
 	dbPort := os.Getenv("DB_PORT")
 	dbName := os.Getenv("DB_DATABASE")
 
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		log.Fatal("JWT_SECRET must be set")
+	}
+	jwtExpiry := 24 * time.Hour
+	if raw := os.Getenv("JWT_EXPIRY_HOURS"); raw != "" {
+		hours, err := strconv.Atoi(raw)
+		if err != nil {
+			log.Fatalf("Invalid JWT_EXPIRY_HOURS: %v", err)
+		}
+		jwtExpiry = time.Duration(hours) * time.Hour
+	}
+
 	// Construct MySQL DSN
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s", dbUser, dbPass, dbHost, dbPort, dbName)
 
@@ -46,6 +62,8 @@ func main() {
 	}
 	defer db.Close()
 
+	configureConnectionPool(db)
+
 	// Verify database connection
 	dbErr = db.Ping()
 	if dbErr != nil {
@@ -58,142 +76,78 @@ func main() {
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"https://internship-profile.vercel.app"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE"},
-		AllowHeaders:     []string{"Content-Type"},
+		AllowHeaders:     []string{"Content-Type", "Authorization"},
 		AllowCredentials: true,
 	}))
 
-	router.GET("/data", getData)
-	router.GET("/data/:date", getDataByDate)
-	router.POST("/data", createData)
-	router.PUT("/data/:date", updateData)
-	router.DELETE("/data/:date", deleteData)
+	authHandler := handlers.NewAuthHandler(db, jwtSecret, jwtExpiry)
+	dataRepo := repository.NewMySQLDataRepository(db)
+	dataHandler := handlers.NewDataHandler(dataRepo)
+	healthHandler := handlers.NewHealthHandler(db)
 
-	// Start the server
-	if err := router.Run(":8080"); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
-	}
-}
+	router.GET("/healthz", healthHandler.Liveness)
+	router.GET("/readyz", healthHandler.Readiness)
 
-// getData handles the retrieval of all data entries
-func getData(c *gin.Context) {
-	fmt.Println("Hello from getData function")
+	router.POST("/register", authHandler.Register)
+	router.POST("/login", authHandler.Login)
 
-	stmt := "SELECT * FROM data"
-	rows, err := db.Query(stmt)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	defer rows.Close()
-
-	var dataEntries []Data
-	for rows.Next() {
-		var data Data
-		if err := rows.Scan(&data.Date, &data.Day, &data.Tasks); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-		dataEntries = append(dataEntries, data)
-	}
-	c.JSON(http.StatusOK, dataEntries)
-}
+	users := router.Group("/users")
+	users.Use(middleware.Auth(jwtSecret))
+	users.PUT("/:id/role", middleware.RequireRole("admin"), authHandler.UpdateUserRole)
 
-// getDataByDate handles the retrieval of a single data entry by its date
-func getDataByDate(c *gin.Context) {
-	date := c.Param("date")
-	stmt := "SELECT * FROM data WHERE date = ?"
-	row := db.QueryRow(stmt, date)
-
-	var data Data
-	if err := row.Scan(&data.Date, &data.Day, &data.Tasks); err != nil {
-		if err == sql.ErrNoRows {
-			c.JSON(http.StatusNotFound, gin.H{"message": "Data not found"})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		}
-		return
-	}
-	c.JSON(http.StatusOK, data)
-}
-
-// createData handles the creation of a new data entry
-func createData(c *gin.Context) {
-	fmt.Println("Hello from createData function")
-	var newData Data
-	if err := c.BindJSON(&newData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": "Failed to bind JSON: " + err.Error()})
-		return
+	authorized := router.Group("/data")
+	authorized.Use(middleware.Auth(jwtSecret))
+	{
+		authorized.GET("", dataHandler.GetData)
+		authorized.GET("/id/:id", dataHandler.GetDataByID)
+		authorized.GET("/date/:date", dataHandler.GetDataByDate)
+		authorized.POST("", middleware.RequireRole("admin", "editor"), dataHandler.CreateData)
+		authorized.PUT("/id/:id", middleware.RequireRole("admin", "editor"), dataHandler.UpdateData)
+		authorized.DELETE("/id/:id", middleware.RequireRole("admin", "editor"), dataHandler.DeleteData)
 	}
 
-	stmt := "INSERT INTO data (date, day, tasks) VALUES (?, ?, ?)"
-	res, err := db.Exec(stmt, newData.Date, newData.Day, newData.Tasks)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+	srv := &http.Server{
+		Addr:    ":8080",
+		Handler: router,
 	}
 
-	id, err := res.LastInsertId()
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	newData.Date = int(id)
-	c.JSON(http.StatusCreated, newData)
-}
-
-// updateData handles the updating of an existing data entry by its date
-func updateData(c *gin.Context) {
-	fmt.Println("Hello from updateData function")
-	date := c.Param("date")
-	var updatedData Data
-	if err := c.BindJSON(&updatedData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": "Failed to bind JSON: " + err.Error()})
-		return
-	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("Failed to start server: %v", err)
+		}
+	}()
 
-	stmt := "UPDATE data SET day = ?, tasks = ? WHERE date = ?"
-	res, err := db.Exec(stmt, updatedData.Day, updatedData.Tasks, date)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
 
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Fatalf("Graceful shutdown failed: %v", err)
 	}
+}
 
-	if rowsAffected == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"message": "Data not found"})
-		return
-	}
+// configureConnectionPool tunes the pool from env vars, falling back to
+// sane defaults for a small service.
+func configureConnectionPool(db *sql.DB) {
+	maxOpenConns := envInt("DB_MAX_OPEN_CONNS", 25)
+	maxIdleConns := envInt("DB_MAX_IDLE_CONNS", 25)
+	connMaxLifetimeMinutes := envInt("DB_CONN_MAX_LIFETIME_MINUTES", 5)
 
-	c.JSON(http.StatusOK, gin.H{"message": "Data successfully updated"})
+	db.SetMaxOpenConns(maxOpenConns)
+	db.SetMaxIdleConns(maxIdleConns)
+	db.SetConnMaxLifetime(time.Duration(connMaxLifetimeMinutes) * time.Minute)
 }
 
-// deleteData handles the deletion of a data entry by its date
-func deleteData(c *gin.Context) {
-	date := c.Param("date")
-
-	stmt := "DELETE FROM data WHERE date = ?"
-	res, err := db.Exec(stmt, date)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+func envInt(key string, fallback int) int {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return fallback
 	}
-
-	rowsAffected, err := res.RowsAffected()
+	value, err := strconv.Atoi(raw)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	if rowsAffected == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"message": "Data not found"})
-		return
+		log.Fatalf("Invalid %s: %v", key, err)
 	}
-
-	c.JSON(http.StatusOK, gin.H{"message": "Data successfully deleted"})
+	return value
 }
-
@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	"go-crud-data/apierror"
+)
+
+// Claims holds the JWT payload used throughout the app
+type Claims struct {
+	UserID   int    `json:"user_id"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// Auth validates the "Authorization: Bearer <token>" header and, on
+// success, stores the decoded claims in the gin.Context under "user".
+func Auth(secret string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if header == "" {
+			apierror.Unauthorized(c, "Authorization header required")
+			return
+		}
+
+		parts := strings.SplitN(header, " ", 2)
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			apierror.Unauthorized(c, "Authorization header must be 'Bearer <token>'")
+			return
+		}
+
+		claims := &Claims{}
+		token, err := jwt.ParseWithClaims(parts[1], claims, func(t *jwt.Token) (interface{}, error) {
+			return []byte(secret), nil
+		})
+		if err != nil || !token.Valid {
+			apierror.Unauthorized(c, "Invalid or expired token")
+			return
+		}
+
+		c.Set("user", claims)
+		c.Next()
+	}
+}
+
+// RequireRole aborts the request with 403 unless the authenticated user's
+// role (set by Auth) is one of the allowed roles.
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, exists := c.Get("user")
+		if !exists {
+			apierror.Unauthorized(c, "Authentication required")
+			return
+		}
+
+		claims, ok := value.(*Claims)
+		if !ok {
+			apierror.Internal(c, errors.New("invalid auth context"))
+			return
+		}
+
+		for _, role := range roles {
+			if claims.Role == role {
+				c.Next()
+				return
+			}
+		}
+
+		apierror.Forbidden(c, "Insufficient permissions")
+	}
+}
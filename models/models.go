@@ -0,0 +1,33 @@
+package models
+
+// Data represents a data entry in the database. ID is the auto-increment
+// primary key; Date is a caller-supplied business field and is not
+// guaranteed unique.
+type Data struct {
+	ID    int    `json:"id"`
+	Date  int    `json:"date"`
+	Day   string `json:"day"`
+	Tasks string `json:"tasks"`
+}
+
+// User represents an application account used for authentication
+type User struct {
+	ID           int    `json:"id"`
+	Username     string `json:"username"`
+	PasswordHash string `json:"-"`
+	Role         string `json:"role"`
+}
+
+// CreateDataRequest is the validated payload accepted by POST /data
+type CreateDataRequest struct {
+	Date  int    `json:"date" binding:"required,gt=0"`
+	Day   string `json:"day" binding:"required,min=1,max=255"`
+	Tasks string `json:"tasks" binding:"max=10000"`
+}
+
+// UpdateDataRequest is the validated payload accepted by PUT /data/id/:id
+type UpdateDataRequest struct {
+	Date  int    `json:"date" binding:"required,gt=0"`
+	Day   string `json:"day" binding:"required,min=1,max=255"`
+	Tasks string `json:"tasks" binding:"max=10000"`
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"go-crud-data/models"
+)
+
+// ErrNotFound is returned when a lookup or mutation targets a row that
+// does not exist in the repository.
+var ErrNotFound = errors.New("data not found")
+
+// sortColumns whitelists the columns GetAll may order by, preventing
+// caller-supplied strings from being interpolated into SQL.
+var sortColumns = map[string]bool{
+	"date": true,
+	"day":  true,
+}
+
+// ListParams controls pagination, filtering, and sorting for GetAll.
+type ListParams struct {
+	Limit      int
+	Offset     int
+	Day        string // optional exact-match filter; empty means no filter
+	SortColumn string // must be a key of sortColumns
+	SortOrder  string // "asc" or "desc"
+}
+
+// DataRepository abstracts storage for Data entries so handlers can be
+// tested without a live MySQL instance. Every method takes a context so
+// callers can cancel in-flight queries on client disconnect or shutdown.
+type DataRepository interface {
+	GetAll(ctx context.Context, params ListParams) (entries []models.Data, total int, err error)
+	GetByID(ctx context.Context, id int) (models.Data, error)
+	GetByDate(ctx context.Context, date int) (models.Data, error)
+	Create(ctx context.Context, data models.Data) (models.Data, error)
+	Update(ctx context.Context, id int, data models.Data) error
+	Delete(ctx context.Context, id int) error
+}
+
+// MySQLDataRepository is a DataRepository backed by a *sql.DB
+type MySQLDataRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLDataRepository constructs a MySQLDataRepository backed by db
+func NewMySQLDataRepository(db *sql.DB) *MySQLDataRepository {
+	return &MySQLDataRepository{db: db}
+}
+
+func (r *MySQLDataRepository) GetAll(ctx context.Context, params ListParams) ([]models.Data, int, error) {
+	sortColumn := "date"
+	if sortColumns[params.SortColumn] {
+		sortColumn = params.SortColumn
+	}
+	sortOrder := "ASC"
+	if params.SortOrder == "desc" {
+		sortOrder = "DESC"
+	}
+
+	where := ""
+	args := []interface{}{}
+	if params.Day != "" {
+		where = " WHERE day = ?"
+		args = append(args, params.Day)
+	}
+
+	var total int
+	countStmt := "SELECT COUNT(*) FROM data" + where
+	if err := r.db.QueryRowContext(ctx, countStmt, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	stmt := fmt.Sprintf("SELECT id, date, day, tasks FROM data%s ORDER BY %s %s LIMIT ? OFFSET ?", where, sortColumn, sortOrder)
+	rows, err := r.db.QueryContext(ctx, stmt, append(args, params.Limit, params.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var entries []models.Data
+	for rows.Next() {
+		var data models.Data
+		if err := rows.Scan(&data.ID, &data.Date, &data.Day, &data.Tasks); err != nil {
+			return nil, 0, err
+		}
+		entries = append(entries, data)
+	}
+	return entries, total, rows.Err()
+}
+
+func (r *MySQLDataRepository) GetByID(ctx context.Context, id int) (models.Data, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT id, date, day, tasks FROM data WHERE id = ?", id)
+
+	var data models.Data
+	if err := row.Scan(&data.ID, &data.Date, &data.Day, &data.Tasks); err != nil {
+		if err == sql.ErrNoRows {
+			return models.Data{}, ErrNotFound
+		}
+		return models.Data{}, err
+	}
+	return data, nil
+}
+
+func (r *MySQLDataRepository) GetByDate(ctx context.Context, date int) (models.Data, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT id, date, day, tasks FROM data WHERE date = ?", date)
+
+	var data models.Data
+	if err := row.Scan(&data.ID, &data.Date, &data.Day, &data.Tasks); err != nil {
+		if err == sql.ErrNoRows {
+			return models.Data{}, ErrNotFound
+		}
+		return models.Data{}, err
+	}
+	return data, nil
+}
+
+func (r *MySQLDataRepository) Create(ctx context.Context, data models.Data) (models.Data, error) {
+	res, err := r.db.ExecContext(ctx, "INSERT INTO data (date, day, tasks) VALUES (?, ?, ?)", data.Date, data.Day, data.Tasks)
+	if err != nil {
+		return models.Data{}, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return models.Data{}, err
+	}
+	data.ID = int(id)
+	return data, nil
+}
+
+func (r *MySQLDataRepository) Update(ctx context.Context, id int, data models.Data) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE data SET date = ?, day = ?, tasks = ? WHERE id = ?", data.Date, data.Day, data.Tasks, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *MySQLDataRepository) Delete(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM data WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
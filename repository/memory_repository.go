@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"go-crud-data/models"
+)
+
+// InMemoryDataRepository is a DataRepository backed by a map, intended for
+// tests and local development without MySQL. It takes a context on every
+// method to satisfy DataRepository but, having no I/O, never blocks on it.
+type InMemoryDataRepository struct {
+	mu      sync.RWMutex
+	entries map[int]models.Data
+	nextID  int
+}
+
+// NewInMemoryDataRepository constructs an empty InMemoryDataRepository
+func NewInMemoryDataRepository() *InMemoryDataRepository {
+	return &InMemoryDataRepository{entries: make(map[int]models.Data), nextID: 1}
+}
+
+func (r *InMemoryDataRepository) GetAll(ctx context.Context, params ListParams) ([]models.Data, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	filtered := make([]models.Data, 0, len(r.entries))
+	for _, data := range r.entries {
+		if params.Day != "" && data.Day != params.Day {
+			continue
+		}
+		filtered = append(filtered, data)
+	}
+
+	less := func(i, j int) bool {
+		if params.SortColumn == "day" {
+			return filtered[i].Day < filtered[j].Day
+		}
+		return filtered[i].Date < filtered[j].Date
+	}
+	if params.SortOrder == "desc" {
+		sort.SliceStable(filtered, func(i, j int) bool { return less(j, i) })
+	} else {
+		sort.SliceStable(filtered, func(i, j int) bool { return less(i, j) })
+	}
+
+	total := len(filtered)
+
+	start := params.Offset
+	if start > total {
+		start = total
+	}
+	end := start + params.Limit
+	if params.Limit <= 0 || end > total {
+		end = total
+	}
+
+	return filtered[start:end], total, nil
+}
+
+func (r *InMemoryDataRepository) GetByID(ctx context.Context, id int) (models.Data, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	data, ok := r.entries[id]
+	if !ok {
+		return models.Data{}, ErrNotFound
+	}
+	return data, nil
+}
+
+func (r *InMemoryDataRepository) GetByDate(ctx context.Context, date int) (models.Data, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, data := range r.entries {
+		if data.Date == date {
+			return data, nil
+		}
+	}
+	return models.Data{}, ErrNotFound
+}
+
+func (r *InMemoryDataRepository) Create(ctx context.Context, data models.Data) (models.Data, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data.ID = r.nextID
+	r.nextID++
+	r.entries[data.ID] = data
+	return data, nil
+}
+
+func (r *InMemoryDataRepository) Update(ctx context.Context, id int, data models.Data) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.entries[id]; !ok {
+		return ErrNotFound
+	}
+	data.ID = id
+	r.entries[id] = data
+	return nil
+}
+
+func (r *InMemoryDataRepository) Delete(ctx context.Context, id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.entries[id]; !ok {
+		return ErrNotFound
+	}
+	delete(r.entries, id)
+	return nil
+}